@@ -162,6 +162,7 @@ type clientSecretItem struct {
 	Status       string `json:"status,omitempty"`
 	Id           string `json:"id,omitempty"`
 	ClientSecret string `json:"client_secret,omitempty"`
+	Created      string `json:"created,omitempty"`
 	LastUpdated  string `json:"lastUpdated,omitempty"`
 }
 
@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEmailTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the `okta_email_template` to resolve.",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Language tag to resolve. Falls back to the template's `default_language` if this translation does not exist.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resolved subject for the requested (or default) language.",
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resolved body for the requested (or default) language.",
+			},
+			"resolved_language": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Language the `subject`/`template` were actually resolved from.",
+			},
+		},
+	}
+}
+
+func dataSourceEmailTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	templateID := d.Get("template_id").(string)
+	supplement := getSupplementFromMetadata(m)
+	template, _, err := supplement.GetEmailTemplate(ctx, templateID)
+	if err != nil {
+		return diag.Errorf("failed to get email template: %v", err)
+	}
+
+	language := d.Get("language").(string)
+	subject, body, resolvedLanguage := template.Subject, template.Template, template.DefaultLanguage
+	if language != "" && language != template.DefaultLanguage {
+		translation, resp, err := supplement.GetEmailTemplateTranslation(ctx, templateID, language)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to get email template translation: %v", err)
+		}
+		if translation != nil {
+			subject, body, resolvedLanguage = translation.Subject, translation.Template, language
+		}
+	}
+
+	d.SetId(templateID)
+	_ = d.Set("subject", subject)
+	_ = d.Set("template", body)
+	_ = d.Set("resolved_language", resolvedLanguage)
+	return nil
+}
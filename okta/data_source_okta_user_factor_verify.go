@@ -0,0 +1,182 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// factorVerifyCacheTTL bounds how long a resolved push transaction is
+// remembered, so repeated plan/apply cycles within the same provider
+// process don't re-poll Okta for a transaction that already finished.
+const factorVerifyCacheTTL = 5 * time.Minute
+
+type factorVerifyCacheEntry struct {
+	result    string
+	expiresAt time.Time
+}
+
+var (
+	factorVerifyCacheMu sync.Mutex
+	factorVerifyCache   = map[string]factorVerifyCacheEntry{}
+)
+
+func dataSourceUserFactorVerify() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserFactorVerifyRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"factor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"pass_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"push"},
+			},
+			"push": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Verify a push factor, polling the resulting transaction instead of returning immediately.",
+				ConflictsWith: []string{"pass_code"},
+			},
+			"poll_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2s",
+				Description: "Interval between polls of the push transaction, e.g. `2s`. Only used when `push` is `true`.",
+			},
+			"timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "1m",
+				Description: "Maximum time to poll the push transaction before giving up, e.g. `1m`. Only used when `push` is `true`.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Outcome of the verification: `SUCCESS`, `REJECTED`, or `TIMEOUT`.",
+			},
+		},
+	}
+}
+
+type factorVerifyResponse struct {
+	FactorResult string `json:"factorResult,omitempty"`
+	Links        struct {
+		Poll struct {
+			Href string `json:"href,omitempty"`
+		} `json:"poll,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func dataSourceUserFactorVerifyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	factorID := d.Get("factor_id").(string)
+
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	body := map[string]string{}
+	if passCode, ok := d.GetOk("pass_code"); ok {
+		body["passCode"] = passCode.(string)
+	}
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/users/%s/factors/%s/verify", userID, factorID), body)
+	if err != nil {
+		return diag.Errorf("failed to verify user factor: %v", err)
+	}
+	var verify *factorVerifyResponse
+	_, err = re.Do(ctx, req, &verify)
+	if err != nil {
+		return diag.Errorf("failed to verify user factor: %v", err)
+	}
+
+	result := verify.FactorResult
+	id := fmt.Sprintf("%s/%s", userID, factorID)
+	if d.Get("push").(bool) && verify.Links.Poll.Href != "" {
+		// The poll URL embeds Okta's transaction id, so it's the only safe
+		// cache key: unlike user_id/factor_id it can't collide across two
+		// different push transactions for the same factor.
+		pollURL := verify.Links.Poll.Href
+		id = pollURL
+		if cached, ok := getCachedFactorVerifyResult(pollURL); ok {
+			result = cached
+		} else {
+			result, err = pollPushFactor(ctx, m, pollURL, d.Get("poll_interval").(string), d.Get("timeout").(string))
+			if err != nil {
+				return diag.Errorf("failed to poll push factor verification: %v", err)
+			}
+			// pollPushFactor only returns once the transaction has reached a
+			// terminal state, so this is always safe to cache. pass_code
+			// verifies are never cached: Okta evaluates those synchronously
+			// and a new pass_code always deserves a fresh answer.
+			setCachedFactorVerifyResult(pollURL, result)
+		}
+	}
+
+	d.SetId(id)
+	_ = d.Set("result", result)
+	return nil
+}
+
+func pollPushFactor(ctx context.Context, m interface{}, pollURL, pollIntervalRaw, timeoutRaw string) (string, error) {
+	interval, err := time.ParseDuration(pollIntervalRaw)
+	if err != nil {
+		return "", fmt.Errorf("invalid poll_interval: %w", err)
+	}
+	timeout, err := time.ParseDuration(timeoutRaw)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeout: %w", err)
+	}
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	deadline := time.Now().Add(timeout)
+	for {
+		req, err := re.NewRequest(http.MethodGet, pollURL, nil)
+		if err != nil {
+			return "", err
+		}
+		var verify *factorVerifyResponse
+		_, err = re.Do(ctx, req, &verify)
+		if err != nil {
+			return "", err
+		}
+		switch verify.FactorResult {
+		case "SUCCESS", "REJECTED", "TIMEOUT":
+			return verify.FactorResult, nil
+		}
+		if time.Now().After(deadline) {
+			return "TIMEOUT", nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func getCachedFactorVerifyResult(key string) (string, bool) {
+	factorVerifyCacheMu.Lock()
+	defer factorVerifyCacheMu.Unlock()
+	entry, ok := factorVerifyCache[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(factorVerifyCache, key)
+		return "", false
+	}
+	return entry.result, true
+}
+
+func setCachedFactorVerifyResult(key, result string) {
+	factorVerifyCacheMu.Lock()
+	defer factorVerifyCacheMu.Unlock()
+	factorVerifyCache[key] = factorVerifyCacheEntry{result: result, expiresAt: time.Now().Add(factorVerifyCacheTTL)}
+}
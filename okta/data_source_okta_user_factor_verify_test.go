@@ -0,0 +1,31 @@
+package okta
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFactorVerifyCacheKeyedByTransaction(t *testing.T) {
+	pollURLA := "https://example.okta.com/api/v1/users/u1/factors/f1/transactions/tx-a"
+	pollURLB := "https://example.okta.com/api/v1/users/u1/factors/f1/transactions/tx-b"
+
+	setCachedFactorVerifyResult(pollURLA, "SUCCESS")
+
+	if _, ok := getCachedFactorVerifyResult(pollURLB); ok {
+		t.Fatalf("expected no cache hit for a different transaction's poll URL")
+	}
+	if result, ok := getCachedFactorVerifyResult(pollURLA); !ok || result != "SUCCESS" {
+		t.Fatalf("expected cached SUCCESS for pollURLA, got %q (ok=%v)", result, ok)
+	}
+}
+
+func TestFactorVerifyCacheExpires(t *testing.T) {
+	key := "https://example.okta.com/api/v1/users/u2/factors/f2/transactions/tx-c"
+	factorVerifyCacheMu.Lock()
+	factorVerifyCache[key] = factorVerifyCacheEntry{result: "REJECTED", expiresAt: time.Now().Add(-time.Second)}
+	factorVerifyCacheMu.Unlock()
+
+	if _, ok := getCachedFactorVerifyResult(key); ok {
+		t.Fatalf("expected expired cache entry to be treated as a miss")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -35,15 +36,31 @@ func dataSourceUsers() *schema.Resource {
 				Default:     false,
 				Description: "Fetch user roles for each user",
 			},
+			"include_factors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fetch MFA factor enrollment state for each user",
+			},
 			"search": {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Description:   userSearchSchemaDescription,
-				ConflictsWith: []string{"group_id"},
+				ConflictsWith: []string{"group_id", "filter"},
 				Elem: &schema.Resource{
 					Schema: userSearchSchema,
 				},
 			},
+			"filter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Compound SCIM search expression. Supports grouping (`any`/`all`), negation (`not`), and the full comparison operator set, compiling down to the same `search` query string the Users API expects. Cannot be combined with `search` or `group_id`.",
+				ConflictsWith: []string{"group_id", "search"},
+				Elem: &schema.Resource{
+					Schema: userFilterSchema(),
+				},
+			},
 			"users": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -54,6 +71,31 @@ func dataSourceUsers() *schema.Resource {
 								Type:     schema.TypeString,
 								Computed: true,
 							},
+							"factors": {
+								Type:        schema.TypeList,
+								Computed:    true,
+								Description: "MFA factors enrolled for the user. Only populated when `include_factors` is `true`.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"id": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"factor_type": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"provider": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"status": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
 						}),
 				},
 			},
@@ -69,10 +111,87 @@ func dataSourceUsers() *schema.Resource {
 				Optional:    true,
 				Description: "Force delay of the users read by N seconds. Useful when eventual consistency of users information needs to be allowed for.",
 			},
+			"max_results": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Caps the number of users fetched across paginated search results. `0` (the default) walks every page via `resp.HasNextPage()`.",
+			},
+			"sort_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Property to sort results by, e.g. `profile.lastName`.",
+			},
+			"sort_order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ASCENDING",
+				ValidateDiagFunc: elemInSlice([]string{"ASCENDING", "DESCENDING"}),
+				Description:      "Sort order to use when `sort_by` is set. One of `ASCENDING` or `DESCENDING`.",
+			},
 		},
 	}
 }
 
+// userFilterSchema is the schema for a single node of the recursive `filter`
+// block. "any"/"all" group child nodes with OR/AND, "not" negates a single
+// child node, and "clause" is a leaf comparison. The map is built then
+// patched in place so "any"/"all"/"not" can refer back to this same node
+// type, giving the block unbounded nesting depth.
+func userFilterSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"clause": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Leaf comparison, e.g. `{ name = \"profile.department\", op = \"eq\", value = \"Eng\" }`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "SCIM attribute path, e.g. `profile.department` or `status`.",
+					},
+					"op": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: elemInSlice([]string{"eq", "sw", "co", "pr", "gt", "ge", "lt", "le"}),
+						Description:      "Comparison operator: one of `eq`, `sw`, `co`, `pr`, `gt`, `ge`, `lt`, `le`.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Value to compare against. Not used with the unary `pr` operator.",
+					},
+				},
+			},
+		},
+	}
+	node := &schema.Resource{Schema: s}
+	s["any"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MinItems:    1,
+		Description: "Child nodes joined with OR.",
+		Elem:        node,
+	}
+	s["all"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MinItems:    1,
+		Description: "Child nodes joined with AND.",
+		Elem:        node,
+	}
+	s["not"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Single child node, negated.",
+		Elem:        node,
+	}
+	return s
+}
+
 func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if n, ok := d.GetOk("delay_read_seconds"); ok {
 		delay, err := strconv.Atoi(n.(string))
@@ -92,15 +211,33 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	client := getOktaClientFromMetadata(m)
 
+	maxResults := d.Get("max_results").(int)
+	// Leave sortOrder empty (so query.Params omits it) unless sort_by is set;
+	// a sortOrder is only sent together with a sortBy.
+	sortOrder := ""
+	sortBy := d.Get("sort_by").(string)
+	if sortBy != "" {
+		sortOrder = d.Get("sort_order").(string)
+	}
+
 	if groupId, ok := d.GetOk("group_id"); ok {
 		id = groupId.(string)
 		users, err = listGroupUsers(ctx, m, id)
+	} else if filterBlocks, ok := d.GetOk("filter"); ok {
+		var search string
+		search, err = compileUserFilter(filterBlocks.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return diag.Errorf("invalid filter: %v", err)
+		}
+		params := &query.Params{Search: search, Limit: firstPageLimit(maxResults), SortBy: sortBy, SortOrder: sortOrder}
+		id = fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(params.String())))
+		users, err = collectUsers(ctx, client, params, maxResults)
 	} else if _, ok := d.GetOk("search"); ok {
-		params := &query.Params{Search: getSearchCriteria(d), Limit: defaultPaginationLimit, SortOrder: "0"}
+		params := &query.Params{Search: getSearchCriteria(d), Limit: firstPageLimit(maxResults), SortBy: sortBy, SortOrder: sortOrder}
 		id = fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(params.String())))
-		users, err = collectUsers(ctx, client, params)
+		users, err = collectUsers(ctx, client, params, maxResults)
 	} else {
-		return diag.Errorf("must specify either group_id or search attributes")
+		return diag.Errorf("must specify one of group_id, filter, or search attributes")
 	}
 
 	if err != nil {
@@ -109,6 +246,7 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 	d.SetId(id)
 	includeGroups := d.Get("include_groups").(bool)
 	includeRoles := d.Get("include_roles").(bool)
+	includeFactors := d.Get("include_factors").(bool)
 	arr := make([]map[string]interface{}, len(users))
 	for i, user := range users {
 		rawMap := flattenUser(user)
@@ -127,6 +265,13 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 			}
 			rawMap["admin_roles"] = roles
 		}
+		if includeFactors {
+			factors, err := listUserFactors(ctx, m, user.Id)
+			if err != nil {
+				return diag.Errorf("failed to list user's MFA factors: %v", err)
+			}
+			rawMap["factors"] = factors
+		}
 		arr[i] = rawMap
 	}
 
@@ -134,12 +279,25 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 	return nil
 }
 
-func collectUsers(ctx context.Context, client *okta.Client, qp *query.Params) ([]*okta.User, error) {
+// firstPageLimit bounds the page size of the first search request to
+// maxResults when a cap is set, so a capped query doesn't fetch a full
+// defaultPaginationLimit page when far fewer results were asked for.
+func firstPageLimit(maxResults int) int64 {
+	if maxResults > 0 && maxResults < defaultPaginationLimit {
+		return int64(maxResults)
+	}
+	return defaultPaginationLimit
+}
+
+// collectUsers walks paginated search results, stopping once maxResults
+// users have been collected. maxResults <= 0 means no cap: walk every page
+// via resp.HasNextPage(), as before.
+func collectUsers(ctx context.Context, client *okta.Client, qp *query.Params, maxResults int) ([]*okta.User, error) {
 	users, resp, err := client.User.ListUsers(ctx, qp)
 	if err != nil {
 		return nil, err
 	}
-	for resp.HasNextPage() {
+	for resp.HasNextPage() && (maxResults <= 0 || len(users) < maxResults) {
 		var nextUsers []*okta.User
 		resp, err = resp.Next(ctx, &nextUsers)
 		if err != nil {
@@ -149,5 +307,64 @@ func collectUsers(ctx context.Context, client *okta.Client, qp *query.Params) ([
 			users = append(users, nextUsers[i])
 		}
 	}
+	if maxResults > 0 && len(users) > maxResults {
+		users = users[:maxResults]
+	}
 	return users, nil
 }
+
+// compileUserFilter recursively compiles a `filter` block into an Okta SCIM
+// search expression, e.g.
+//
+//	((profile.department eq "Eng" and (profile.title sw "Sr" or profile.title sw "Staff")) and not status eq "SUSPENDED")
+func compileUserFilter(node map[string]interface{}) (string, error) {
+	hasClause := len(node["clause"].([]interface{})) > 0
+	hasAny := len(node["any"].([]interface{})) > 0
+	hasAll := len(node["all"].([]interface{})) > 0
+	hasNot := len(node["not"].([]interface{})) > 0
+	set := 0
+	for _, b := range []bool{hasClause, hasAny, hasAll, hasNot} {
+		if b {
+			set++
+		}
+	}
+	if set != 1 {
+		return "", fmt.Errorf("filter node must set exactly one of clause, any, all, or not, got %d", set)
+	}
+
+	switch {
+	case hasClause:
+		clause := node["clause"].([]interface{})[0].(map[string]interface{})
+		name := clause["name"].(string)
+		op := clause["op"].(string)
+		if op == "pr" {
+			return fmt.Sprintf("%s pr", name), nil
+		}
+		return fmt.Sprintf("%s %s %q", name, op, clause["value"].(string)), nil
+	case hasAny:
+		return compileUserFilterGroup(node["any"].([]interface{}), " or ")
+	case hasAll:
+		return compileUserFilterGroup(node["all"].([]interface{}), " and ")
+	default: // hasNot
+		inner, err := compileUserFilter(node["not"].([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("not %s", inner), nil
+	}
+}
+
+func compileUserFilterGroup(children []interface{}, joiner string) (string, error) {
+	parts := make([]string, len(children))
+	for i, child := range children {
+		expr, err := compileUserFilter(child.(map[string]interface{}))
+		if err != nil {
+			return "", err
+		}
+		parts[i] = expr
+	}
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	return "(" + strings.Join(parts, joiner) + ")", nil
+}
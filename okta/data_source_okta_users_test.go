@@ -0,0 +1,134 @@
+package okta
+
+import "testing"
+
+func emptyFilterNode() map[string]interface{} {
+	return map[string]interface{}{
+		"clause": []interface{}{},
+		"any":    []interface{}{},
+		"all":    []interface{}{},
+		"not":    []interface{}{},
+	}
+}
+
+func clauseNode(name, op, value string) map[string]interface{} {
+	node := emptyFilterNode()
+	node["clause"] = []interface{}{
+		map[string]interface{}{"name": name, "op": op, "value": value},
+	}
+	return node
+}
+
+func TestCompileUserFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		node map[string]interface{}
+		want string
+	}{
+		{
+			name: "single clause",
+			node: clauseNode("profile.department", "eq", "Eng"),
+			want: `profile.department eq "Eng"`,
+		},
+		{
+			name: "pr operator omits value",
+			node: clauseNode("profile.department", "pr", ""),
+			want: "profile.department pr",
+		},
+		{
+			name: "all joins with and",
+			node: func() map[string]interface{} {
+				n := emptyFilterNode()
+				n["all"] = []interface{}{
+					clauseNode("profile.department", "eq", "Eng"),
+					clauseNode("profile.title", "sw", "Sr"),
+				}
+				return n
+			}(),
+			want: `(profile.department eq "Eng" and profile.title sw "Sr")`,
+		},
+		{
+			name: "any joins with or",
+			node: func() map[string]interface{} {
+				n := emptyFilterNode()
+				n["any"] = []interface{}{
+					clauseNode("profile.title", "sw", "Sr"),
+					clauseNode("profile.title", "sw", "Staff"),
+				}
+				return n
+			}(),
+			want: `(profile.title sw "Sr" or profile.title sw "Staff")`,
+		},
+		{
+			name: "not negates its single child",
+			node: func() map[string]interface{} {
+				n := emptyFilterNode()
+				n["not"] = []interface{}{clauseNode("status", "eq", "SUSPENDED")}
+				return n
+			}(),
+			want: `not status eq "SUSPENDED"`,
+		},
+		{
+			name: "nested any/all/not",
+			node: func() map[string]interface{} {
+				dept := clauseNode("profile.department", "eq", "Eng")
+				titleAny := emptyFilterNode()
+				titleAny["any"] = []interface{}{
+					clauseNode("profile.title", "sw", "Sr"),
+					clauseNode("profile.title", "sw", "Staff"),
+				}
+				all := emptyFilterNode()
+				all["all"] = []interface{}{dept, titleAny}
+				notSuspended := emptyFilterNode()
+				notSuspended["not"] = []interface{}{clauseNode("status", "eq", "SUSPENDED")}
+				top := emptyFilterNode()
+				top["all"] = []interface{}{all, notSuspended}
+				return top
+			}(),
+			want: `((profile.department eq "Eng" and (profile.title sw "Sr" or profile.title sw "Staff")) and not status eq "SUSPENDED")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := compileUserFilter(tt.node)
+			if err != nil {
+				t.Fatalf("compileUserFilter() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("compileUserFilter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileUserFilterRejectsAmbiguousNode(t *testing.T) {
+	node := clauseNode("profile.department", "eq", "Eng")
+	node["all"] = []interface{}{clauseNode("profile.title", "sw", "Sr")}
+
+	if _, err := compileUserFilter(node); err == nil {
+		t.Fatal("expected an error when a node sets both clause and all, got nil")
+	}
+}
+
+func TestCompileUserFilterRejectsEmptyNode(t *testing.T) {
+	if _, err := compileUserFilter(emptyFilterNode()); err == nil {
+		t.Fatal("expected an error for a node with none of clause/any/all/not set, got nil")
+	}
+}
+
+func TestFirstPageLimit(t *testing.T) {
+	tests := []struct {
+		maxResults int
+		want       int64
+	}{
+		{0, defaultPaginationLimit},
+		{-1, defaultPaginationLimit},
+		{10, 10},
+		{defaultPaginationLimit + 50, defaultPaginationLimit},
+	}
+	for _, tt := range tests {
+		if got := firstPageLimit(tt.maxResults); got != tt.want {
+			t.Errorf("firstPageLimit(%d) = %d, want %d", tt.maxResults, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAppOAuthClientSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppOAuthClientSecretCreate,
+		ReadContext:   resourceAppOAuthClientSecretRead,
+		UpdateContext: resourceAppOAuthClientSecretUpdate,
+		DeleteContext: resourceAppOAuthClientSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the OAuth application this client secret belongs to.",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ACTIVE",
+				ValidateDiagFunc: elemInSlice([]string{"ACTIVE", "INACTIVE"}),
+				Description:      "Status of the client secret. Can be `ACTIVE` or `INACTIVE`.",
+			},
+			"rotate_when": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, forces creation of a new client secret. Analogous to the `keepers` pattern used elsewhere in Terraform, this lets callers drive rotation from an external trigger (e.g. a rotation timestamp) without otherwise changing the resource's configuration.",
+			},
+			"staged_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "When `true`, after creating this secret the provider waits `deactivation_delay` and then deactivates the other (older) secret on the application, leaving it in place (but inactive) so it can still be deleted explicitly, e.g. by removing its `okta_app_oauth_client_secret` resource on a subsequent apply. This allows the new secret to be propagated to downstream systems before the old one stops working. When `false`, the other secret is left unchanged.",
+			},
+			"deactivation_delay": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Duration to wait before deactivating the sibling secret, e.g. `1h`. Only used when `staged_rotation` is `true`. Parsed with Go's `time.ParseDuration`.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth client secret value.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the client secret was created.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the client secret was last updated.",
+			},
+			"peer_secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the other client secret on this application, if one exists. There can be only two client secrets on an OAuth app at a time, so downstream resources can use this to reference the secret being replaced.",
+			},
+		},
+	}
+}
+
+func resourceAppOAuthClientSecretCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appID), nil)
+	if err != nil {
+		return diag.Errorf("failed to create OAuth client secret: %v", err)
+	}
+	var secret *clientSecretItem
+	_, err = re.Do(ctx, req, &secret)
+	if err != nil {
+		return diag.Errorf("failed to create OAuth client secret: %v", err)
+	}
+	d.SetId(secret.Id)
+
+	peerID, err := findPeerClientSecretID(ctx, m, appID, secret.Id)
+	if err != nil {
+		return diag.Errorf("failed to look up peer OAuth client secret: %v", err)
+	}
+
+	status := d.Get("status").(string)
+	if status != "ACTIVE" {
+		if err := setClientSecretStatus(ctx, m, appID, secret.Id, status); err != nil {
+			return diag.Errorf("failed to set OAuth client secret status: %v", err)
+		}
+	}
+
+	if d.Get("staged_rotation").(bool) && peerID != "" {
+		if delay := d.Get("deactivation_delay").(string); delay != "" {
+			dur, err := time.ParseDuration(delay)
+			if err != nil {
+				return diag.Errorf("invalid deactivation_delay: %v", err)
+			}
+			logger(m).Info("staged rotation: waiting ", dur, " before deactivating peer client secret ", peerID)
+			timer := time.NewTimer(dur)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return diag.Errorf("staged rotation interrupted while waiting on deactivation_delay: %v", ctx.Err())
+			}
+		}
+		if err := setClientSecretStatus(ctx, m, appID, peerID, "INACTIVE"); err != nil {
+			return diag.Errorf("failed to deactivate peer OAuth client secret: %v", err)
+		}
+	}
+
+	return resourceAppOAuthClientSecretRead(ctx, d, m)
+}
+
+func resourceAppOAuthClientSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	secrets, err := listClientSecrets(ctx, m, appID)
+	if err != nil {
+		return diag.Errorf("failed to list OAuth client secrets: %v", err)
+	}
+	var secret *clientSecretItem
+	peerID := ""
+	for _, s := range secrets {
+		if s.Id == d.Id() {
+			secret = s
+		} else {
+			peerID = s.Id
+		}
+	}
+	if secret == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("status", secret.Status)
+	_ = d.Set("client_secret", secret.ClientSecret)
+	_ = d.Set("created", secret.Created)
+	_ = d.Set("last_updated", secret.LastUpdated)
+	_ = d.Set("peer_secret_id", peerID)
+	return nil
+}
+
+func resourceAppOAuthClientSecretUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("status") {
+		appID := d.Get("app_id").(string)
+		if err := setClientSecretStatus(ctx, m, appID, d.Id(), d.Get("status").(string)); err != nil {
+			return diag.Errorf("failed to update OAuth client secret status: %v", err)
+		}
+	}
+	return resourceAppOAuthClientSecretRead(ctx, d, m)
+}
+
+func resourceAppOAuthClientSecretDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s", appID, d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("failed to delete OAuth client secret: %v", err)
+	}
+	_, err = re.Do(ctx, req, nil)
+	if err != nil {
+		return diag.Errorf("failed to delete OAuth client secret: %v", err)
+	}
+	return nil
+}
+
+// listClientSecrets returns the (at most two) client secrets configured on an OAuth app.
+func listClientSecrets(ctx context.Context, m interface{}, appID string) ([]*clientSecretItem, error) {
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []*clientSecretItem
+	_, err = re.Do(ctx, req, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// findPeerClientSecretID returns the id of the secret on appID that is not secretID, if any.
+func findPeerClientSecretID(ctx context.Context, m interface{}, appID, secretID string) (string, error) {
+	secrets, err := listClientSecrets(ctx, m, appID)
+	if err != nil {
+		return "", err
+	}
+	for _, s := range secrets {
+		if s.Id != secretID {
+			return s.Id, nil
+		}
+	}
+	return "", nil
+}
+
+func setClientSecretStatus(ctx context.Context, m interface{}, appID, secretID, status string) error {
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s/lifecycle/%s", appID, secretID, clientSecretLifecycleAction(status)), nil)
+	if err != nil {
+		return err
+	}
+	_, err = re.Do(ctx, req, nil)
+	return err
+}
+
+// clientSecretLifecycleAction maps the desired status to the Okta lifecycle
+// action that puts a client secret into it.
+func clientSecretLifecycleAction(status string) string {
+	if status == "ACTIVE" {
+		return "activate"
+	}
+	return "deactivate"
+}
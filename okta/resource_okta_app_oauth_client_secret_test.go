@@ -0,0 +1,19 @@
+package okta
+
+import "testing"
+
+func TestClientSecretLifecycleAction(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{"ACTIVE", "activate"},
+		{"INACTIVE", "deactivate"},
+		{"", "deactivate"},
+	}
+	for _, tt := range tests {
+		if got := clientSecretLifecycleAction(tt.status); got != tt.want {
+			t.Errorf("clientSecretLifecycleAction(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
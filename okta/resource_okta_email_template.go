@@ -0,0 +1,142 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// emailTemplateRequiredVars lists the Velocity variables each template type
+// is expected to reference. Okta silently ignores templates that omit them,
+// so create and update check for them before calling the API.
+var emailTemplateRequiredVars = map[string][]string{
+	"UserActivation": {"${activationLink}"},
+	"PasswordReset":  {"${resetPasswordLink}"},
+	"ForgotPassword": {"${resetPasswordLink}"},
+	"AccountLockout": {"${user.firstName}"},
+}
+
+func resourceEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailTemplateCreate,
+		ReadContext:   resourceEmailTemplateRead,
+		UpdateContext: resourceEmailTemplateUpdate,
+		DeleteContext: resourceEmailTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the email template.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the email template, e.g. `UserActivation`, `PasswordReset`, `ForgotPassword`, `AccountLockout`.",
+			},
+			"default_language": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Language tag (e.g. `en`) used when a translation does not exist for the requested language.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Subject of the default language version of the email template.",
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Body of the default language version of the email template. Often loaded with `file(...)`.",
+			},
+		},
+	}
+}
+
+func resourceEmailTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body := buildEmailTemplate(d)
+	if err := validateEmailTemplateVars(body.Type, body.Subject, body.Template); err != nil {
+		return diag.FromErr(err)
+	}
+	template, _, err := getSupplementFromMetadata(m).CreateEmailTemplate(ctx, body, nil)
+	if err != nil {
+		return diag.Errorf("failed to create email template: %v", err)
+	}
+	d.SetId(template.Id)
+	return resourceEmailTemplateRead(ctx, d, m)
+}
+
+func resourceEmailTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template, resp, err := getSupplementFromMetadata(m).GetEmailTemplate(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get email template: %v", err)
+	}
+	if template == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", template.Name)
+	_ = d.Set("type", template.Type)
+	_ = d.Set("default_language", template.DefaultLanguage)
+	_ = d.Set("subject", template.Subject)
+	_ = d.Set("template", template.Template)
+	return nil
+}
+
+func resourceEmailTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body := buildEmailTemplate(d)
+	if err := validateEmailTemplateVars(body.Type, body.Subject, body.Template); err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateEmailTemplate(ctx, d.Id(), body, nil)
+	if err != nil {
+		return diag.Errorf("failed to update email template: %v", err)
+	}
+	return resourceEmailTemplateRead(ctx, d, m)
+}
+
+func resourceEmailTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, err := getSupplementFromMetadata(m).DeleteEmailTemplate(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to delete email template: %v", err)
+	}
+	return nil
+}
+
+func buildEmailTemplate(d *schema.ResourceData) sdk.SdkEmailTemplate {
+	return sdk.SdkEmailTemplate{
+		Name:            d.Get("name").(string),
+		Type:            d.Get("type").(string),
+		DefaultLanguage: d.Get("default_language").(string),
+		Subject:         d.Get("subject").(string),
+		Template:        d.Get("template").(string),
+	}
+}
+
+// validateEmailTemplateVars ensures the Velocity variables Okta expects for
+// a given template type are present in the subject/body being deployed.
+func validateEmailTemplateVars(templateType, subject, template string) error {
+	required, ok := emailTemplateRequiredVars[templateType]
+	if !ok {
+		return nil
+	}
+	body := subject + "\n" + template
+	var missing []string
+	for _, v := range required {
+		if !strings.Contains(body, v) {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("email template of type %q is missing required variable(s): %s", templateType, strings.Join(missing, ", "))
+	}
+	return nil
+}
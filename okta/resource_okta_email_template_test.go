@@ -0,0 +1,50 @@
+package okta
+
+import "testing"
+
+func TestValidateEmailTemplateVars(t *testing.T) {
+	tests := []struct {
+		name         string
+		templateType string
+		subject      string
+		template     string
+		wantErr      bool
+	}{
+		{
+			name:         "unknown type is not validated",
+			templateType: "CustomTemplate",
+			subject:      "hi",
+			template:     "no variables here",
+			wantErr:      false,
+		},
+		{
+			name:         "required variable present in template",
+			templateType: "UserActivation",
+			subject:      "Activate your account",
+			template:     "Click ${activationLink} to activate.",
+			wantErr:      false,
+		},
+		{
+			name:         "required variable present in subject only",
+			templateType: "PasswordReset",
+			subject:      "Reset: ${resetPasswordLink}",
+			template:     "no variable here",
+			wantErr:      false,
+		},
+		{
+			name:         "missing required variable",
+			templateType: "UserActivation",
+			subject:      "Activate your account",
+			template:     "Welcome aboard!",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEmailTemplateVars(tt.templateType, tt.subject, tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateEmailTemplateVars() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceEmailTemplateTranslation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailTemplateTranslationCreate,
+		ReadContext:   resourceEmailTemplateTranslationRead,
+		UpdateContext: resourceEmailTemplateTranslationUpdate,
+		DeleteContext: resourceEmailTemplateTranslationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the `okta_email_template` this translation belongs to.",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Language tag of this translation, e.g. `es` or `fr-CA`.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Subject of the translated email template.",
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Body of the translated email template. Often loaded with `file(...)`.",
+			},
+		},
+	}
+}
+
+func resourceEmailTemplateTranslationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	templateID := d.Get("template_id").(string)
+	language := d.Get("language").(string)
+	if err := validateEmailTemplateTranslationVars(ctx, m, d); err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateEmailTemplateTranslation(ctx, templateID, language, buildEmailTemplateTranslation(d))
+	if err != nil {
+		return diag.Errorf("failed to create email template translation: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", templateID, language))
+	return resourceEmailTemplateTranslationRead(ctx, d, m)
+}
+
+func resourceEmailTemplateTranslationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	templateID := d.Get("template_id").(string)
+	language := d.Get("language").(string)
+	translation, resp, err := getSupplementFromMetadata(m).GetEmailTemplateTranslation(ctx, templateID, language)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get email template translation: %v", err)
+	}
+	if translation == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("subject", translation.Subject)
+	_ = d.Set("template", translation.Template)
+	return nil
+}
+
+func resourceEmailTemplateTranslationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateEmailTemplateTranslationVars(ctx, m, d); err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateEmailTemplateTranslation(ctx, d.Get("template_id").(string), d.Get("language").(string), buildEmailTemplateTranslation(d))
+	if err != nil {
+		return diag.Errorf("failed to update email template translation: %v", err)
+	}
+	return resourceEmailTemplateTranslationRead(ctx, d, m)
+}
+
+func resourceEmailTemplateTranslationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, err := getSupplementFromMetadata(m).DeleteEmailTemplateTranslation(ctx, d.Get("template_id").(string), d.Get("language").(string))
+	if err != nil {
+		return diag.Errorf("failed to delete email template translation: %v", err)
+	}
+	return nil
+}
+
+func buildEmailTemplateTranslation(d *schema.ResourceData) sdk.SdkEmailTranslation {
+	return sdk.SdkEmailTranslation{
+		Subject:  d.Get("subject").(string),
+		Template: d.Get("template").(string),
+	}
+}
+
+// validateEmailTemplateTranslationVars looks up the parent template's type
+// so translations are held to the same required-variable check as the
+// default language version.
+func validateEmailTemplateTranslationVars(ctx context.Context, m interface{}, d *schema.ResourceData) error {
+	template, _, err := getSupplementFromMetadata(m).GetEmailTemplate(ctx, d.Get("template_id").(string))
+	if err != nil {
+		return fmt.Errorf("failed to look up parent email template: %w", err)
+	}
+	return validateEmailTemplateVars(template.Type, d.Get("subject").(string), d.Get("template").(string))
+}
@@ -0,0 +1,194 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var validFactorTypes = []string{
+	"push", "token:software:totp", "sms", "call", "question", "webauthn", "u2f", "email",
+}
+
+func resourceUserFactor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserFactorCreate,
+		ReadContext:   resourceUserFactorRead,
+		UpdateContext: resourceUserFactorUpdate,
+		DeleteContext: resourceUserFactorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to enroll the factor for.",
+			},
+			"factor_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(validFactorTypes),
+				Description:      "Type of factor to enroll. One of: " + fmt.Sprintf("%v", validFactorTypes),
+			},
+			"factor_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "OKTA",
+				Description: "Factor provider, e.g. `OKTA`, `GOOGLE`, `DUO`, `FIDO`.",
+			},
+			"profile": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Factor profile, e.g. `phoneNumber` for `sms`/`call`, or `credentialId` for `webauthn`/`u2f`.",
+			},
+			"activation_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "One-time passcode used to activate factors that require out-of-band verification, e.g. `sms`, `call`, `token:software:totp`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the factor, e.g. `PENDING_ACTIVATION` or `ACTIVE`.",
+			},
+		},
+	}
+}
+
+type userFactor struct {
+	Id         string                 `json:"id,omitempty"`
+	FactorType string                 `json:"factorType,omitempty"`
+	Provider   string                 `json:"provider,omitempty"`
+	Status     string                 `json:"status,omitempty"`
+	Profile    map[string]interface{} `json:"profile,omitempty"`
+}
+
+func resourceUserFactorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	body := userFactor{
+		FactorType: d.Get("factor_type").(string),
+		Provider:   d.Get("factor_provider").(string),
+		Profile:    convertInterfaceToStringMap(d.Get("profile")),
+	}
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/users/%s/factors", userID), body)
+	if err != nil {
+		return diag.Errorf("failed to enroll user factor: %v", err)
+	}
+	var factor *userFactor
+	_, err = re.Do(ctx, req, &factor)
+	if err != nil {
+		return diag.Errorf("failed to enroll user factor: %v", err)
+	}
+	d.SetId(factor.Id)
+
+	if factor.Status == "PENDING_ACTIVATION" {
+		if code, ok := d.GetOk("activation_code"); ok {
+			if err := activateUserFactor(ctx, m, userID, factor.Id, code.(string)); err != nil {
+				return diag.Errorf("failed to activate user factor: %v", err)
+			}
+		}
+	}
+
+	return resourceUserFactorRead(ctx, d, m)
+}
+
+func resourceUserFactorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/users/%s/factors/%s", userID, d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("failed to get user factor: %v", err)
+	}
+	var factor *userFactor
+	resp, err := re.Do(ctx, req, &factor)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to get user factor: %v", err)
+	}
+	_ = d.Set("factor_type", factor.FactorType)
+	_ = d.Set("factor_provider", factor.Provider)
+	_ = d.Set("status", factor.Status)
+	return nil
+}
+
+func resourceUserFactorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	if d.Get("status").(string) == "PENDING_ACTIVATION" && d.HasChange("activation_code") {
+		code := d.Get("activation_code").(string)
+		if err := activateUserFactor(ctx, m, userID, d.Id(), code); err != nil {
+			return diag.Errorf("failed to re-activate user factor: %v", err)
+		}
+	}
+	return resourceUserFactorRead(ctx, d, m)
+}
+
+func resourceUserFactorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/users/%s/factors/%s/lifecycle/reset", userID, d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("failed to reset user factor: %v", err)
+	}
+	_, err = re.Do(ctx, req, nil)
+	if err != nil {
+		return diag.Errorf("failed to reset user factor: %v", err)
+	}
+	return nil
+}
+
+func activateUserFactor(ctx context.Context, m interface{}, userID, factorID, passCode string) error {
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/users/%s/factors/%s/lifecycle/activate", userID, factorID), map[string]string{"passCode": passCode})
+	if err != nil {
+		return err
+	}
+	_, err = re.Do(ctx, req, nil)
+	return err
+}
+
+func convertInterfaceToStringMap(i interface{}) map[string]interface{} {
+	raw, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return raw
+}
+
+// listUserFactors returns a flattened view of a user's enrolled MFA factors,
+// suitable for use as a TypeList of maps in data source schemas.
+func listUserFactors(ctx context.Context, m interface{}, userID string) ([]map[string]interface{}, error) {
+	re := getOktaClientFromMetadata(m).GetRequestExecutor()
+	req, err := re.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/users/%s/factors", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var factors []*userFactor
+	_, err = re.Do(ctx, req, &factors)
+	if err != nil {
+		return nil, err
+	}
+	arr := make([]map[string]interface{}, len(factors))
+	for i, f := range factors {
+		arr[i] = map[string]interface{}{
+			"id":          f.Id,
+			"factor_type": f.FactorType,
+			"provider":    f.Provider,
+			"status":      f.Status,
+		}
+	}
+	return arr, nil
+}
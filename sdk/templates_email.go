@@ -83,3 +83,54 @@ func (m *APISupplement) DeleteEmailTemplate(ctx context.Context, id string) (*Re
 	}
 	return m.RequestExecutor.Do(ctx, req, nil)
 }
+
+func (m *APISupplement) ListEmailTemplateTranslations(ctx context.Context, templateID string) (map[string]*SdkEmailTranslation, *Response, error) {
+	url := fmt.Sprintf("/api/v1/templates/emails/%s/translations", templateID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var translations map[string]*SdkEmailTranslation
+	resp, err := m.RequestExecutor.Do(ctx, req, &translations)
+	if err != nil {
+		return nil, resp, err
+	}
+	return translations, resp, err
+}
+
+func (m *APISupplement) GetEmailTemplateTranslation(ctx context.Context, templateID, language string) (*SdkEmailTranslation, *Response, error) {
+	url := fmt.Sprintf("/api/v1/templates/emails/%s/translations/%s", templateID, language)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var translation *SdkEmailTranslation
+	resp, err := m.RequestExecutor.Do(ctx, req, &translation)
+	if err != nil {
+		return nil, resp, err
+	}
+	return translation, resp, err
+}
+
+func (m *APISupplement) UpdateEmailTemplateTranslation(ctx context.Context, templateID, language string, body SdkEmailTranslation) (*SdkEmailTranslation, *Response, error) {
+	url := fmt.Sprintf("/api/v1/templates/emails/%s/translations/%s", templateID, language)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var translation *SdkEmailTranslation
+	resp, err := m.RequestExecutor.Do(ctx, req, &translation)
+	if err != nil {
+		return nil, resp, err
+	}
+	return translation, resp, err
+}
+
+func (m *APISupplement) DeleteEmailTemplateTranslation(ctx context.Context, templateID, language string) (*Response, error) {
+	url := fmt.Sprintf("/api/v1/templates/emails/%s/translations/%s", templateID, language)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}